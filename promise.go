@@ -1,7 +1,13 @@
 package promise
 
 import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"strings"
 	"sync"
+	"time"
 )
 
 // Promise is a object that can be used to get the
@@ -14,10 +20,24 @@ type Promise[T any] interface {
 	// immediately.
 	Await() (T, error)
 
+	// AwaitContext returns the result of the async operation,
+	// just like Await. If the given context is canceled before
+	// the operation completes, AwaitContext unblocks immediately
+	// and returns the zero value of T along with ctx.Err().
+	AwaitContext(ctx context.Context) (T, error)
+
 	// AwaitOr returns the result of the async operation
 	// or a default value if the operation was not successful.
 	AwaitOr(defaultValue T) T
 
+	// TryAwait returns the result of the async operation without
+	// blocking. If the operation has not completed yet, ok is false
+	// and the value and error are the zero value and nil respectively.
+	TryAwait() (value T, err error, ok bool)
+
+	// State returns the current state of the promise.
+	State() State
+
 	// Then executes the given functions when the promise
 	// is either fulfilled or rejected respectively.
 	// The functions are executed in a goroutine.
@@ -59,33 +79,55 @@ type Promise[T any] interface {
 	Done() <-chan struct{}
 }
 
-// promise is an implementation of the Promise interface.
-type promise[T any] struct {
-	wg    sync.WaitGroup
-	once  sync.Once
-	done  chan struct{}
-	value T
-	err   error
-	fn    func() (T, error)
+// State describes where a Promise currently is in its lifecycle.
+type State int
+
+const (
+	// Pending indicates the promise has not yet settled.
+	Pending State = iota
+
+	// Fulfilled indicates the promise settled without an error.
+	Fulfilled
+
+	// Rejected indicates the promise settled with an error, either
+	// returned by its function or, for NewWithContext, ctx.Err().
+	Rejected
+)
+
+func (s State) String() string {
+	switch s {
+	case Fulfilled:
+		return "Fulfilled"
+	case Rejected:
+		return "Rejected"
+	default:
+		return "Pending"
+	}
 }
 
-func (p *promise[T]) get() {
-	p.once.Do(func() {
-		p.wg.Add(1)
-		go func() {
-			defer p.wg.Done()
-			p.value, p.err = p.fn()
-			close(p.done)
-		}()
-	})
-	p.wg.Wait()
+// promise is an implementation of the Promise interface.
+type promise[T any] struct {
+	done   chan struct{}
+	value  T
+	err    error
+	cancel context.CancelFunc
 }
 
 func (p *promise[T]) Await() (T, error) {
-	p.get()
+	<-p.done
 	return p.value, p.err
 }
 
+func (p *promise[T]) AwaitContext(ctx context.Context) (T, error) {
+	select {
+	case <-p.done:
+		return p.value, p.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
 func (p *promise[T]) AwaitOr(defaultValue T) T {
 	if value, err := p.Await(); err == nil {
 		return value
@@ -93,45 +135,123 @@ func (p *promise[T]) AwaitOr(defaultValue T) T {
 	return defaultValue
 }
 
+func (p *promise[T]) TryAwait() (value T, err error, ok bool) {
+	select {
+	case <-p.done:
+		return p.value, p.err, true
+	default:
+		return value, nil, false
+	}
+}
+
+func (p *promise[T]) State() State {
+	select {
+	case <-p.done:
+		if p.err != nil {
+			return Rejected
+		}
+		return Fulfilled
+	default:
+		return Pending
+	}
+}
+
 func (p *promise[T]) Then(onSuccess func(T), onFailure func(error)) {
 	go func() {
-		if p.get(); p.err == nil {
-			onSuccess(p.value)
+		if value, err := p.Await(); err == nil {
+			onSuccess(value)
 		} else {
-			onFailure(p.err)
+			onFailure(err)
 		}
 	}()
 }
 
 func (p *promise[T]) OnSuccess(fn func(T)) {
 	go func() {
-		if p.get(); p.err == nil {
-			fn(p.value)
+		if value, err := p.Await(); err == nil {
+			fn(value)
 		}
 	}()
 }
 
 func (p *promise[T]) OnFailure(fn func(error)) {
 	go func() {
-		if p.get(); p.err != nil {
-			fn(p.err)
+		if _, err := p.Await(); err != nil {
+			fn(err)
 		}
 	}()
 }
 
 func (p *promise[T]) Done() <-chan struct{} {
-	go p.get()
 	return p.done
 }
 
 // New returns a new Promise of type T.
-// The given function will be executed in a goroutine.
+// The given function is executed in a goroutine started immediately,
+// so that Done and State never need to spawn work of their own.
 // The function should return the result of an async operation
 // or an error if the operation failed.
 func New[T any](fn func() (T, error)) Promise[T] {
-	return &promise[T]{
-		fn:   fn,
-		done: make(chan struct{}),
+	p := &promise[T]{
+		done:   make(chan struct{}),
+		cancel: func() {},
+	}
+
+	go func() {
+		p.value, p.err = fn()
+		close(p.done)
+	}()
+
+	return p
+}
+
+// NewWithContext returns a new Promise of type T, just like New.
+// The given context is passed through to fn and is canceled
+// automatically once the promise settles, freeing any resources
+// fn is holding on to. If the context is canceled before fn returns,
+// the promise resolves early with ctx.Err() rather than waiting for
+// fn to notice the cancellation on its own.
+func NewWithContext[T any](ctx context.Context, fn func(context.Context) (T, error)) Promise[T] {
+	ctx, cancel := context.WithCancel(ctx)
+
+	p := &promise[T]{
+		done:   make(chan struct{}),
+		cancel: cancel,
+	}
+
+	go func() {
+		defer cancel()
+
+		type result struct {
+			value T
+			err   error
+		}
+
+		c := make(chan result, 1)
+		go func() {
+			value, err := fn(ctx)
+			c <- result{value, err}
+		}()
+
+		select {
+		case <-ctx.Done():
+			p.err = ctx.Err()
+		case r := <-c:
+			p.value, p.err = r.value, r.err
+		}
+		close(p.done)
+	}()
+
+	return p
+}
+
+// cancelAll cancels the context of every promise in ps that was created
+// with NewWithContext. Promises created with New are unaffected.
+func cancelAll[T any](ps []Promise[T]) {
+	for _, p := range ps {
+		if cp, ok := p.(*promise[T]); ok {
+			cp.cancel()
+		}
 	}
 }
 
@@ -164,6 +284,7 @@ func All[T any](ps ...Promise[T]) Promise[[]T] {
 						once.Do(func() {
 							err = perr
 							close(cancel)
+							cancelAll(ps)
 						})
 						return
 					}
@@ -207,6 +328,7 @@ func Race[T any](ps ...Promise[T]) Promise[T] {
 							t = val
 						}
 						close(done)
+						cancelAll(ps)
 					})
 				}
 			}(i, p)
@@ -217,3 +339,325 @@ func Race[T any](ps ...Promise[T]) Promise[T] {
 		return
 	})
 }
+
+// Result is the settled outcome of a single promise, as returned by
+// AllSettled. Value and Err are the value/error pair returned by
+// Await on that promise, stored as-is: a rejected promise's Value is
+// whatever its function returned alongside the error.
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// AllSettled takes multiple promises and returns a single promise,
+// which will resolve to a slice of Result, one per input promise,
+// once every promise has either been fulfilled or rejected.
+//
+// Unlike All, AllSettled never rejects: a failing promise is reflected
+// in its Result.Err rather than failing the whole aggregation.
+func AllSettled[T any](ps ...Promise[T]) Promise[[]Result[T]] {
+	return New(func() ([]Result[T], error) {
+		if len(ps) == 0 {
+			return make([]Result[T], 0), nil
+		}
+
+		var wg sync.WaitGroup
+		res := make([]Result[T], len(ps))
+
+		wg.Add(len(ps))
+		for i, p := range ps {
+			go func(i int, p Promise[T]) {
+				defer wg.Done()
+				value, err := p.Await()
+				res[i] = Result[T]{Value: value, Err: err}
+			}(i, p)
+		}
+		wg.Wait()
+
+		return res, nil
+	})
+}
+
+// AggregateError is returned by Any when every promise passed to it
+// was rejected. Its Errors field holds the individual errors in the
+// same order as the promises that produced them; it has no Unwrap
+// method, so errors.Is and errors.As do not look inside it.
+type AggregateError struct {
+	Errors []error
+}
+
+func (e *AggregateError) Error() string {
+	var b strings.Builder
+	b.WriteString("all promises were rejected: [")
+	for i, err := range e.Errors {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(err.Error())
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+// Any takes multiple promises and returns a single promise, which
+// will resolve with the value of the first promise to be fulfilled.
+//
+// If every promise rejects, the returned promise rejects as well
+// with an *AggregateError containing all of their errors.
+func Any[T any](ps ...Promise[T]) Promise[T] {
+	return New(func() (t T, err error) {
+		if len(ps) == 0 {
+			return t, &AggregateError{}
+		}
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var once sync.Once
+		done := make(chan struct{})
+		errs := make([]error, len(ps))
+		remaining := len(ps)
+
+		wg.Add(len(ps))
+		for i, p := range ps {
+			go func(i int, p Promise[T]) {
+				defer wg.Done()
+				select {
+				case <-done:
+					return
+				case <-p.Done():
+					val, perr := p.Await()
+					if perr == nil {
+						once.Do(func() {
+							t = val
+							close(done)
+							cancelAll(ps)
+						})
+						return
+					}
+
+					mu.Lock()
+					errs[i] = perr
+					remaining--
+					last := remaining == 0
+					mu.Unlock()
+
+					if last {
+						once.Do(func() {
+							err = &AggregateError{Errors: errs}
+							close(done)
+						})
+					}
+				}
+			}(i, p)
+		}
+		<-done
+		wg.Wait()
+
+		return
+	})
+}
+
+// Map takes a Promise[T] and a transformation function, and returns
+// a new Promise[U] that resolves once p has resolved and fn has been
+// applied to its value.
+//
+// If p rejects, the returned promise rejects with the same error
+// without calling fn.
+func Map[T, U any](p Promise[T], fn func(T) (U, error)) Promise[U] {
+	return New(func() (U, error) {
+		value, err := p.Await()
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return fn(value)
+	})
+}
+
+// FlatMap takes a Promise[T] and a function returning a Promise[U],
+// and returns a new Promise[U] that resolves once p has resolved and
+// the promise returned by fn has resolved in turn.
+//
+// If p rejects, the returned promise rejects with the same error
+// without calling fn.
+func FlatMap[T, U any](p Promise[T], fn func(T) Promise[U]) Promise[U] {
+	return New(func() (U, error) {
+		value, err := p.Await()
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return fn(value).Await()
+	})
+}
+
+// Catch takes a Promise[T] and a recovery function, and returns a new
+// Promise[T] that resolves to p's value if p is fulfilled, or to the
+// result of calling fn with p's error if p is rejected.
+//
+// This allows a rejected promise to be recovered into a fulfilled one.
+func Catch[T any](p Promise[T], fn func(error) (T, error)) Promise[T] {
+	return New(func() (T, error) {
+		value, err := p.Await()
+		if err != nil {
+			return fn(err)
+		}
+		return value, nil
+	})
+}
+
+// RetryPolicy configures how Retry retries a failing operation.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times fn is invoked,
+	// including the first, non-retried attempt. Values <= 0 are
+	// treated as 1, i.e. fn is tried exactly once.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between retries. A value <= 0
+	// disables the cap.
+	MaxBackoff time.Duration
+
+	// Multiplier is applied to the backoff after every attempt,
+	// growing it exponentially.
+	Multiplier float64
+
+	// Jitter controls how much of the backoff is randomized, from 0
+	// (no jitter, always sleep for the full backoff) to 1 (full
+	// jitter, sleep for a random duration between 0 and the backoff).
+	Jitter float64
+
+	// RetryIf reports whether fn should be retried for the given
+	// error. If nil, every error is retried.
+	RetryIf func(error) bool
+}
+
+// Retry takes a function and a RetryPolicy, and returns a Promise
+// that resolves to the result of the first successful call to fn,
+// retrying with exponential backoff in between attempts:
+//
+//	backoff = min(MaxBackoff, InitialBackoff * Multiplier^attempt)
+//	sleep   = backoff * (1 - Jitter) + rand.Float64() * backoff * Jitter
+//
+// Jitter controls how much of the backoff is randomized: 0 sleeps
+// for the full backoff every time, 1 gives full jitter (a random
+// duration between 0 and backoff), and values in between blend the
+// two.
+//
+// Retry stops early, without exhausting MaxAttempts, once RetryIf
+// returns false for an error. If every attempt fails, the returned
+// promise rejects with the last error encountered.
+func Retry[T any](fn func() (T, error), policy RetryPolicy) Promise[T] {
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+
+	return New(func() (T, error) {
+		var value T
+		var err error
+
+		for attempt := 0; attempt < maxAttempts; attempt++ {
+			value, err = fn()
+			if err == nil {
+				return value, nil
+			}
+			if policy.RetryIf != nil && !policy.RetryIf(err) {
+				break
+			}
+			if attempt+1 >= maxAttempts {
+				break
+			}
+
+			backoff := float64(policy.InitialBackoff) * math.Pow(policy.Multiplier, float64(attempt))
+			if policy.MaxBackoff > 0 && backoff > float64(policy.MaxBackoff) {
+				backoff = float64(policy.MaxBackoff)
+			}
+			jitter := policy.Jitter * backoff
+			time.Sleep(time.Duration(backoff - jitter + rand.Float64()*jitter))
+		}
+
+		return value, err
+	})
+}
+
+// ErrTimeout is returned by WithTimeout when the wrapped promise does
+// not complete within the given duration.
+var ErrTimeout = errors.New("promise: timed out")
+
+// WithTimeout takes a Promise[T] and a duration, and returns a new
+// Promise[T] that resolves like p if p settles within d, or rejects
+// with ErrTimeout if d elapses first.
+func WithTimeout[T any](p Promise[T], d time.Duration) Promise[T] {
+	return New(func() (T, error) {
+		select {
+		case <-p.Done():
+			return p.Await()
+		case <-time.After(d):
+			var zero T
+			return zero, ErrTimeout
+		}
+	})
+}
+
+// MapSlice runs fn over items with at most concurrency goroutines in
+// flight, and returns a Promise that resolves to the results in the
+// same order as items. A concurrency <= 0 is treated as 1.
+//
+// Failure semantics match All: the first error cancels the remaining
+// work and is returned as-is, with no partial results.
+func MapSlice[T, U any](items []T, concurrency int, fn func(T) (U, error)) Promise[[]U] {
+	return New(func() (_ []U, err error) {
+		if len(items) == 0 {
+			return make([]U, 0), nil
+		}
+		if concurrency <= 0 {
+			concurrency = 1
+		}
+
+		var wg sync.WaitGroup
+		var once sync.Once
+		cancel := make(chan struct{})
+		sem := make(chan struct{}, concurrency)
+		res := make([]U, len(items))
+
+		wg.Add(len(items))
+		for i, item := range items {
+			select {
+			case <-cancel:
+				wg.Done()
+				continue
+			case sem <- struct{}{}:
+			}
+
+			go func(i int, item T) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				select {
+				case <-cancel:
+					return
+				default:
+				}
+
+				value, ferr := fn(item)
+				if ferr != nil {
+					once.Do(func() {
+						err = ferr
+						close(cancel)
+					})
+					return
+				}
+				res[i] = value
+			}(i, item)
+		}
+		wg.Wait()
+
+		if err != nil {
+			return make([]U, 0), err
+		}
+		return res, nil
+	})
+}
@@ -1,9 +1,13 @@
 package promise
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"reflect"
+	"runtime"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -119,6 +123,68 @@ func TestDone(t *testing.T) {
 	}
 }
 
+func TestAwaitContext(t *testing.T) {
+	t.Run("Resolve", func(t *testing.T) {
+		p := New(func() (bool, error) {
+			<-time.After(100 * time.Millisecond)
+			return true, nil
+		})
+
+		ok, err := p.AwaitContext(context.Background())
+		assertEqual(t, true, ok)
+		assertNil(t, err)
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		p := New(func() (bool, error) {
+			<-time.After(200 * time.Millisecond)
+			return true, nil
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		ok, err := p.AwaitContext(ctx)
+		assertEqual(t, false, ok)
+		assertEqual(t, context.DeadlineExceeded, err)
+		if time.Since(start) >= 200*time.Millisecond {
+			t.Errorf("Test %s: Expected AwaitContext to unblock before the promise resolved", t.Name())
+		}
+	})
+}
+
+func TestNewWithContext(t *testing.T) {
+	t.Run("Resolve", func(t *testing.T) {
+		p := NewWithContext(context.Background(), func(ctx context.Context) (bool, error) {
+			<-time.After(100 * time.Millisecond)
+			return true, nil
+		})
+
+		ok, err := p.Await()
+		assertEqual(t, true, ok)
+		assertNil(t, err)
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		p := NewWithContext(ctx, func(ctx context.Context) (bool, error) {
+			<-ctx.Done()
+			return false, ctx.Err()
+		})
+
+		start := time.Now()
+		ok, err := p.Await()
+		assertEqual(t, false, ok)
+		assertEqual(t, context.DeadlineExceeded, err)
+		if time.Since(start) >= 200*time.Millisecond {
+			t.Errorf("Test %s: Expected promise to resolve shortly after the context deadline", t.Name())
+		}
+	})
+}
+
 func TestAll(t *testing.T) {
 	t.Run("Resolve", func(t *testing.T) {
 		p1 := New(func() (bool, error) {
@@ -193,6 +259,34 @@ func TestAll(t *testing.T) {
 		assertEqual(t, 0, len(res))
 		assertNil(t, err)
 	})
+
+	t.Run("CancelsContextSiblings", func(t *testing.T) {
+		p1 := New(func() (bool, error) {
+			<-time.After(50 * time.Millisecond)
+			return false, errors.New("test error")
+		})
+
+		p2 := NewWithContext(context.Background(), func(ctx context.Context) (bool, error) {
+			<-ctx.Done()
+			return false, ctx.Err()
+		})
+
+		start := time.Now()
+		all := All(p1, p2)
+
+		select {
+		case <-all.Done():
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Test %s: Expected All to settle once p1 failed", t.Name())
+		}
+
+		res, err := p2.Await()
+		assertEqual(t, false, res)
+		assertEqual(t, context.Canceled, err)
+		if time.Since(start) >= 500*time.Millisecond {
+			t.Errorf("Test %s: Expected p2 to be canceled shortly after p1 failed, not run indefinitely", t.Name())
+		}
+	})
 }
 
 func TestRace(t *testing.T) {
@@ -244,4 +338,406 @@ func TestRace(t *testing.T) {
 		assertEqual(t, defaultVal, res)
 		assertNil(t, err)
 	})
+
+	t.Run("CancelsContextSiblings", func(t *testing.T) {
+		p1 := New(func() (bool, error) {
+			<-time.After(50 * time.Millisecond)
+			return true, nil
+		})
+
+		p2 := NewWithContext(context.Background(), func(ctx context.Context) (bool, error) {
+			<-ctx.Done()
+			return false, ctx.Err()
+		})
+
+		start := time.Now()
+		race := Race(p1, p2)
+
+		select {
+		case <-race.Done():
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Test %s: Expected Race to settle once p1 won", t.Name())
+		}
+
+		res, err := p2.Await()
+		assertEqual(t, false, res)
+		assertEqual(t, context.Canceled, err)
+		if time.Since(start) >= 500*time.Millisecond {
+			t.Errorf("Test %s: Expected p2 to be canceled shortly after p1 won, not run indefinitely", t.Name())
+		}
+	})
+}
+
+func TestAllSettled(t *testing.T) {
+	t.Run("Mixed", func(t *testing.T) {
+		expected := errors.New("test error")
+		p1 := New(func() (bool, error) {
+			<-time.After(100 * time.Millisecond)
+			return true, nil
+		})
+
+		p2 := New(func() (bool, error) {
+			<-time.After(200 * time.Millisecond)
+			return false, expected
+		})
+
+		res, err := AllSettled(p1, p2).Await()
+		assertNil(t, err)
+		assertEqual(t, []Result[bool]{{Value: true, Err: nil}, {Value: false, Err: expected}}, res)
+	})
+
+	t.Run("Empty/Default", func(t *testing.T) {
+		res, err := AllSettled[bool]().Await()
+		assertEqual(t, []Result[bool]{}, res)
+		assertEqual(t, 0, len(res))
+		assertNil(t, err)
+	})
+}
+
+func TestAny(t *testing.T) {
+	t.Run("Resolve", func(t *testing.T) {
+		p1 := New(func() (bool, error) {
+			<-time.After(100 * time.Millisecond)
+			return false, errors.New("test error")
+		})
+
+		p2 := New(func() (bool, error) {
+			<-time.After(200 * time.Millisecond)
+			return true, nil
+		})
+
+		start := time.Now()
+		res, err := Any(p1, p2).Await()
+		assertEqual(t, true, res)
+		assertNil(t, err)
+		if time.Since(start) < 200*time.Millisecond {
+			t.Errorf("Test %s: Expected promise to be running for at least 200ms", t.Name())
+		}
+	})
+
+	t.Run("AllRejected", func(t *testing.T) {
+		var defaultVal bool
+		err1 := errors.New("first error")
+		err2 := errors.New("second error")
+		p1 := New(func() (bool, error) {
+			<-time.After(100 * time.Millisecond)
+			return false, err1
+		})
+
+		p2 := New(func() (bool, error) {
+			<-time.After(200 * time.Millisecond)
+			return false, err2
+		})
+
+		res, err := Any(p1, p2).Await()
+		assertEqual(t, defaultVal, res)
+
+		var aggErr *AggregateError
+		if !errors.As(err, &aggErr) {
+			t.Errorf("Test %s: Expected err to be an *AggregateError, Received `%v`", t.Name(), err)
+		}
+		assertEqual(t, []error{err1, err2}, aggErr.Errors)
+	})
+
+	t.Run("Empty/Default", func(t *testing.T) {
+		var defaultVal bool
+		res, err := Any[bool]().Await()
+		assertEqual(t, defaultVal, res)
+		assertNotNil(t, err)
+	})
+}
+
+func TestMap(t *testing.T) {
+	t.Run("Resolve", func(t *testing.T) {
+		p := New(func() (int, error) {
+			return 2, nil
+		})
+
+		res, err := Map(p, func(v int) (string, error) {
+			return strings.Repeat("x", v), nil
+		}).Await()
+		assertEqual(t, "xx", res)
+		assertNil(t, err)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		expected := errors.New("test error")
+		p := New(func() (int, error) {
+			return 0, expected
+		})
+
+		res, err := Map(p, func(v int) (string, error) {
+			t.Errorf("Test %s: Expected fn to not be called", t.Name())
+			return "", nil
+		}).Await()
+		assertEqual(t, "", res)
+		assertEqual(t, expected, err)
+	})
+}
+
+func TestFlatMap(t *testing.T) {
+	t.Run("Resolve", func(t *testing.T) {
+		p := New(func() (int, error) {
+			return 2, nil
+		})
+
+		res, err := FlatMap(p, func(v int) Promise[string] {
+			return New(func() (string, error) {
+				return strings.Repeat("x", v), nil
+			})
+		}).Await()
+		assertEqual(t, "xx", res)
+		assertNil(t, err)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		expected := errors.New("test error")
+		p := New(func() (int, error) {
+			return 0, expected
+		})
+
+		res, err := FlatMap(p, func(v int) Promise[string] {
+			t.Errorf("Test %s: Expected fn to not be called", t.Name())
+			return New(func() (string, error) { return "", nil })
+		}).Await()
+		assertEqual(t, "", res)
+		assertEqual(t, expected, err)
+	})
+}
+
+func TestCatch(t *testing.T) {
+	t.Run("Resolve", func(t *testing.T) {
+		p := New(func() (int, error) {
+			return 2, nil
+		})
+
+		res, err := Catch(p, func(err error) (int, error) {
+			t.Errorf("Test %s: Expected fn to not be called", t.Name())
+			return 0, nil
+		}).Await()
+		assertEqual(t, 2, res)
+		assertNil(t, err)
+	})
+
+	t.Run("Recover", func(t *testing.T) {
+		p := New(func() (int, error) {
+			return 0, errors.New("test error")
+		})
+
+		res, err := Catch(p, func(err error) (int, error) {
+			return 42, nil
+		}).Await()
+		assertEqual(t, 42, res)
+		assertNil(t, err)
+	})
+}
+
+func TestRetry(t *testing.T) {
+	t.Run("Resolve", func(t *testing.T) {
+		attempts := 0
+		fn := func() (int, error) {
+			attempts++
+			if attempts < 3 {
+				return 0, errors.New("test error")
+			}
+			return 42, nil
+		}
+
+		res, err := Retry(fn, RetryPolicy{
+			MaxAttempts:    5,
+			InitialBackoff: 10 * time.Millisecond,
+			MaxBackoff:     50 * time.Millisecond,
+			Multiplier:     2,
+			Jitter:         1,
+		}).Await()
+		assertEqual(t, 42, res)
+		assertNil(t, err)
+		assertEqual(t, 3, attempts)
+	})
+
+	t.Run("MaxAttemptsExceeded", func(t *testing.T) {
+		expected := errors.New("test error")
+		attempts := 0
+		fn := func() (int, error) {
+			attempts++
+			return 0, expected
+		}
+
+		res, err := Retry(fn, RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: 1 * time.Millisecond,
+			Multiplier:     2,
+		}).Await()
+		assertEqual(t, 0, res)
+		assertEqual(t, expected, err)
+		assertEqual(t, 3, attempts)
+	})
+
+	t.Run("RetryIf", func(t *testing.T) {
+		expected := errors.New("do not retry")
+		attempts := 0
+		fn := func() (int, error) {
+			attempts++
+			return 0, expected
+		}
+
+		res, err := Retry(fn, RetryPolicy{
+			MaxAttempts:    5,
+			InitialBackoff: 1 * time.Millisecond,
+			Multiplier:     2,
+			RetryIf: func(err error) bool {
+				return false
+			},
+		}).Await()
+		assertEqual(t, 0, res)
+		assertEqual(t, expected, err)
+		assertEqual(t, 1, attempts)
+	})
+}
+
+func TestWithTimeout(t *testing.T) {
+	t.Run("Resolve", func(t *testing.T) {
+		p := New(func() (bool, error) {
+			<-time.After(50 * time.Millisecond)
+			return true, nil
+		})
+
+		res, err := WithTimeout(p, 200*time.Millisecond).Await()
+		assertEqual(t, true, res)
+		assertNil(t, err)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		p := New(func() (bool, error) {
+			<-time.After(200 * time.Millisecond)
+			return true, nil
+		})
+
+		start := time.Now()
+		res, err := WithTimeout(p, 50*time.Millisecond).Await()
+		assertEqual(t, false, res)
+		assertEqual(t, ErrTimeout, err)
+		if time.Since(start) >= 200*time.Millisecond {
+			t.Errorf("Test %s: Expected WithTimeout to unblock before the promise resolved", t.Name())
+		}
+	})
+}
+
+func TestMapSlice(t *testing.T) {
+	t.Run("Resolve", func(t *testing.T) {
+		items := []int{1, 2, 3, 4, 5}
+
+		var mu sync.Mutex
+		var inFlight, maxInFlight int
+
+		fn := func(v int) (int, error) {
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+
+			<-time.After(50 * time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+
+			return v * v, nil
+		}
+
+		res, err := MapSlice(items, 2, fn).Await()
+		assertEqual(t, []int{1, 4, 9, 16, 25}, res)
+		assertNil(t, err)
+		if maxInFlight > 2 {
+			t.Errorf("Test %s: Expected at most 2 calls to fn in flight, Received %d", t.Name(), maxInFlight)
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		expected := errors.New("test error")
+		items := []int{1, 2, 3}
+
+		fn := func(v int) (int, error) {
+			if v == 2 {
+				return 0, expected
+			}
+			return v, nil
+		}
+
+		res, err := MapSlice(items, 1, fn).Await()
+		assertEqual(t, make([]int, 0), res)
+		assertEqual(t, expected, err)
+	})
+
+	t.Run("Empty/Default", func(t *testing.T) {
+		res, err := MapSlice[int, int](nil, 2, func(v int) (int, error) {
+			return v, nil
+		}).Await()
+		assertEqual(t, []int{}, res)
+		assertNil(t, err)
+	})
+}
+
+func TestState(t *testing.T) {
+	t.Run("Fulfilled", func(t *testing.T) {
+		release := make(chan struct{})
+		p := New(func() (int, error) {
+			<-release
+			return 42, nil
+		})
+
+		assertEqual(t, Pending, p.State())
+
+		value, err, ok := p.TryAwait()
+		assertEqual(t, 0, value)
+		assertNil(t, err)
+		assertEqual(t, false, ok)
+
+		close(release)
+		res, resErr := p.Await()
+		assertEqual(t, 42, res)
+		assertNil(t, resErr)
+
+		assertEqual(t, Fulfilled, p.State())
+
+		value, err, ok = p.TryAwait()
+		assertEqual(t, 42, value)
+		assertNil(t, err)
+		assertEqual(t, true, ok)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		expected := errors.New("test error")
+		p := New(func() (int, error) {
+			return 0, expected
+		})
+
+		_, _ = p.Await()
+		assertEqual(t, Rejected, p.State())
+
+		value, err, ok := p.TryAwait()
+		assertEqual(t, 0, value)
+		assertEqual(t, expected, err)
+		assertEqual(t, true, ok)
+	})
+}
+
+func TestDoneNoGoroutineLeak(t *testing.T) {
+	p := New(func() (bool, error) {
+		return true, nil
+	})
+
+	<-p.Done()
+
+	before := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		<-p.Done()
+	}
+	after := runtime.NumGoroutine()
+
+	if after > before {
+		t.Errorf("Test %s: Expected repeated Done calls to not spawn goroutines, Received %d more goroutines", t.Name(), after-before)
+	}
 }